@@ -0,0 +1,83 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package datastore
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25/mo"
+)
+
+type rm struct {
+	*flags.DatastoreFlag
+
+	force    bool
+	fileType string
+}
+
+func init() {
+	cli.Register("datastore.rm", &rm{})
+}
+
+func (cmd *rm) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.force, "f", false, "Force; ignore error if file does not exist")
+	f.StringVar(&cmd.fileType, "t", "", "File type: file or disk, overrides auto-detection by extension")
+}
+
+func (cmd *rm) Usage() string {
+	return "FILE"
+}
+
+func (cmd *rm) Description() string {
+	return `Remove FILE on DS.
+
+On a vsanDatastore, removing a VM directory also cleans up any vSAN DOM
+objects left orphaned by the delete.
+
+Examples:
+  govc datastore.rm vm-name
+  govc datastore.rm disks/disk1.vmdk
+  govc datastore.rm -f -t disk disk-without-extension`
+}
+
+func (cmd *rm) Run(ctx context.Context, f *flag.FlagSet) error {
+	file := f.Arg(0)
+	if file == "" {
+		return flag.ErrHelp
+	}
+
+	switch cmd.fileType {
+	case "", "file", "disk":
+	default:
+		return flag.ErrHelp
+	}
+
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return err
+	}
+
+	var info mo.Datastore
+	err = property.DefaultCollector(ds.Client()).RetrieveOne(ctx, ds.Reference(), []string{"summary"}, &info)
+	if err != nil {
+		return err
+	}
+
+	m := object.NewDatastoreFileManager(ds, cmd.force, info.Summary.Type == "vsan")
+
+	if cmd.fileType == "" {
+		return m.Delete(ctx, file)
+	}
+
+	return m.DeleteAs(ctx, file, cmd.fileType == "disk")
+}
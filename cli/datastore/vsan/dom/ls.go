@@ -0,0 +1,124 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package dom
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
+	"github.com/vmware/govmomi/object"
+)
+
+type ls struct {
+	*flags.DatastoreFlag
+
+	max  int
+	long bool
+}
+
+func init() {
+	cli.Register("datastore.vsan.dom.ls", &ls{})
+}
+
+func (cmd *ls) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	f.IntVar(&cmd.max, "o", 0, "Limit the number of results, 0 for no limit")
+	f.BoolVar(&cmd.long, "l", false, "Long listing format")
+}
+
+func (cmd *ls) Usage() string {
+	return "[UUID]..."
+}
+
+func (cmd *ls) Description() string {
+	return `List vSAN DOM objects on a vsanDatastore.
+
+Examples:
+  govc datastore.vsan.dom.ls -ds vsanDatastore
+  govc datastore.vsan.dom.ls -ds vsanDatastore -l
+  govc datastore.vsan.dom.ls -ds vsanDatastore -o 10`
+}
+
+type domObject struct {
+	UUID string `json:"uuid"`
+	object.VsanObjExtAttrs
+}
+
+type lsResult struct {
+	cmd     *ls
+	Objects []domObject `json:"objects"`
+}
+
+func (r *lsResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	for _, o := range r.Objects {
+		_, _ = fmt.Fprintf(tw, "%s", o.UUID)
+		if r.cmd.long {
+			_, _ = fmt.Fprintf(tw, "\t%s\t%s\t%s\t%s", o.ObjectClass, o.ObjectType, o.Owner, o.Name)
+		}
+		_, _ = fmt.Fprintln(tw)
+	}
+
+	return tw.Flush()
+}
+
+func (r *lsResult) Dump() interface{} {
+	return r.Objects
+}
+
+func (cmd *ls) vsanInternalSystem(ctx context.Context) (*object.HostVsanInternalSystem, error) {
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return nil, err
+	}
+
+	hosts, err := ds.AttachedHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(hosts) == 0 {
+		return nil, fmt.Errorf("no hosts attached to datastore %q", ds.Name())
+	}
+
+	return hosts[0].ConfigManager().VsanInternalSystem(ctx)
+}
+
+func (cmd *ls) Run(ctx context.Context, f *flag.FlagSet) error {
+	vsan, err := cmd.vsanInternalSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	uuids, err := vsan.QueryVsanObjectUuidsByFilter(ctx, f.Args(), int32(cmd.max))
+	if err != nil {
+		return err
+	}
+
+	res := lsResult{cmd: cmd}
+
+	if cmd.long {
+		attrs, err := vsan.GetVsanObjExtAttrs(ctx, uuids)
+		if err != nil {
+			return err
+		}
+		for _, id := range uuids {
+			res.Objects = append(res.Objects, domObject{UUID: id, VsanObjExtAttrs: attrs[id]})
+		}
+	} else {
+		for _, id := range uuids {
+			res.Objects = append(res.Objects, domObject{UUID: id})
+		}
+	}
+
+	return cmd.WriteResult(&res)
+}
@@ -0,0 +1,81 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package dom
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
+)
+
+type rm struct {
+	*flags.DatastoreFlag
+
+	force bool
+}
+
+func init() {
+	cli.Register("datastore.vsan.dom.rm", &rm{})
+}
+
+func (cmd *rm) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	f.BoolVar(&cmd.force, "f", false, "Delete object(s) even if still referenced")
+}
+
+func (cmd *rm) Usage() string {
+	return "UUID..."
+}
+
+func (cmd *rm) Description() string {
+	return `Delete vSAN DOM objects on a vsanDatastore.
+
+Examples:
+  govc datastore.vsan.dom.rm -ds vsanDatastore uuid1 uuid2
+  govc datastore.vsan.dom.rm -ds vsanDatastore -f uuid1`
+}
+
+func (cmd *rm) Run(ctx context.Context, f *flag.FlagSet) error {
+	uuids := f.Args()
+	if len(uuids) == 0 {
+		return flag.ErrHelp
+	}
+
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return err
+	}
+
+	hosts, err := ds.AttachedHosts(ctx)
+	if err != nil {
+		return err
+	}
+	if len(hosts) == 0 {
+		return fmt.Errorf("no hosts attached to datastore %q", ds.Name())
+	}
+
+	vsan, err := hosts[0].ConfigManager().VsanInternalSystem(ctx)
+	if err != nil {
+		return err
+	}
+
+	results, err := vsan.DeleteVsanObjects(ctx, uuids, cmd.force)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		if !r.Success {
+			return fmt.Errorf("delete %q: %s", r.Uuid, r.FailureReason)
+		}
+	}
+
+	return nil
+}
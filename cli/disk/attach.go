@@ -0,0 +1,103 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type attach struct {
+	*flags.DatastoreFlag
+	*flags.VirtualMachineFlag
+
+	controllerKey int
+	unitNumber    int
+	profile       flags.StringList
+}
+
+func init() {
+	cli.Register("disk.attach", &attach{})
+}
+
+func (cmd *attach) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	cmd.VirtualMachineFlag, ctx = flags.NewVirtualMachineFlag(ctx)
+	cmd.VirtualMachineFlag.Register(ctx, f)
+
+	f.IntVar(&cmd.controllerKey, "controller", 0, "Key of the controller to attach the disk to")
+	f.IntVar(&cmd.unitNumber, "unit", -1, "Unit number on the controller, -1 to let vCenter choose")
+	f.Var(&cmd.profile, "profile", "SPBM storage profile name or ID (can be specified multiple times)")
+}
+
+func (cmd *attach) Process(ctx context.Context) error {
+	if err := cmd.DatastoreFlag.Process(ctx); err != nil {
+		return err
+	}
+	return cmd.VirtualMachineFlag.Process(ctx)
+}
+
+func (cmd *attach) Usage() string {
+	return "ID"
+}
+
+func (cmd *attach) Description() string {
+	return `Attach existing disk ID to VM.
+
+Examples:
+  govc disk.attach -vm $vm e9b06a8b-d047-4d3c-b15b-43ea9608b1a6
+  govc disk.attach -vm $vm -ds $ds -profile my-policy e9b06a8b-d047-4d3c-b15b-43ea9608b1a6`
+}
+
+func (cmd *attach) Run(ctx context.Context, f *flag.FlagSet) error {
+	id := f.Arg(0)
+	if id == "" {
+		return flag.ErrHelp
+	}
+
+	vm, err := cmd.VirtualMachine()
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		return flag.ErrHelp
+	}
+
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return err
+	}
+
+	var unitNumber *int32
+	if cmd.unitNumber >= 0 {
+		u := int32(cmd.unitNumber)
+		unitNumber = &u
+	}
+
+	var profile []types.BaseVirtualMachineProfileSpec
+	if len(cmd.profile) > 0 {
+		pc, err := newProfileClient(ctx, cmd.DatastoreFlag.Client().Client)
+		if err != nil {
+			return err
+		}
+		ids, err := resolveProfileIDs(ctx, pc, cmd.profile)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			profile = append(profile, &types.VirtualMachineDefinedProfileSpec{
+				ProfileId: id.UniqueId,
+			})
+		}
+	}
+
+	return vm.AttachDisk(ctx, id, ds.Reference(), int32(cmd.controllerKey), unitNumber, profile)
+}
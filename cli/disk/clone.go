@@ -0,0 +1,111 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type clone struct {
+	*flags.DatastoreFlag
+
+	name    string
+	profile flags.StringList
+}
+
+func init() {
+	cli.Register("disk.clone", &clone{})
+}
+
+func (cmd *clone) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.name, "name", "", "Name of the cloned disk")
+	f.Var(&cmd.profile, "profile", "SPBM storage profile name or ID (can be specified multiple times)")
+}
+
+func (cmd *clone) Usage() string {
+	return "ID"
+}
+
+func (cmd *clone) Description() string {
+	return `Clone an existing FCD.
+
+The clone is placed on the datastore given by -ds and reflects the disk's
+current state. CloneVStorageObject_Task has no notion of cloning from a
+specific snapshot, so disk.snapshot.* and disk.clone cannot be combined;
+use disk.snapshot.revert first if a specific point in time is needed.
+
+Examples:
+  govc disk.clone -name my-disk-clone e9b06a8b-d047-4d3c-b15b-43ea9608b1a6
+  govc disk.clone -name my-disk-clone -profile my-policy e9b06a8b-d047-4d3c-b15b-43ea9608b1a6`
+}
+
+func (cmd *clone) Run(ctx context.Context, f *flag.FlagSet) error {
+	id := f.Arg(0)
+	if id == "" || cmd.name == "" {
+		return flag.ErrHelp
+	}
+
+	m, err := NewManagerFromFlag(ctx, cmd.DatastoreFlag)
+	if err != nil {
+		return err
+	}
+
+	ds, err := cmd.Datastore()
+	if err != nil {
+		return err
+	}
+
+	var profile []types.BaseVirtualMachineProfileSpec
+	if len(cmd.profile) > 0 {
+		pc, err := newProfileClient(ctx, cmd.DatastoreFlag.Client().Client)
+		if err != nil {
+			return err
+		}
+		ids, err := resolveProfileIDs(ctx, pc, cmd.profile)
+		if err != nil {
+			return err
+		}
+		for _, pid := range ids {
+			profile = append(profile, &types.VirtualMachineDefinedProfileSpec{
+				ProfileId: pid.UniqueId,
+			})
+		}
+	}
+
+	spec := types.VslmCloneSpec{
+		Name:    cmd.name,
+		Profile: profile,
+		BackingSpec: &types.VslmCreateSpecDiskFileBackingSpec{
+			VslmCreateSpecBackingSpec: types.VslmCreateSpecBackingSpec{
+				Datastore: ds.Reference(),
+			},
+		},
+	}
+
+	task, err := m.Clone(ctx, types.ID{Id: id}, spec)
+	if err != nil {
+		return err
+	}
+
+	res, err := task.WaitForResult(ctx)
+	if err != nil {
+		return err
+	}
+
+	if obj, ok := res.Result.(types.VStorageObject); ok {
+		fmt.Fprintln(cmd.Out, obj.Config.Id.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
+)
+
+type detach struct {
+	*flags.VirtualMachineFlag
+}
+
+func init() {
+	cli.Register("disk.detach", &detach{})
+}
+
+func (cmd *detach) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.VirtualMachineFlag, ctx = flags.NewVirtualMachineFlag(ctx)
+	cmd.VirtualMachineFlag.Register(ctx, f)
+}
+
+func (cmd *detach) Process(ctx context.Context) error {
+	return cmd.VirtualMachineFlag.Process(ctx)
+}
+
+func (cmd *detach) Usage() string {
+	return "ID"
+}
+
+func (cmd *detach) Description() string {
+	return `Detach disk ID from VM.
+
+Examples:
+  govc disk.detach -vm $vm e9b06a8b-d047-4d3c-b15b-43ea9608b1a6`
+}
+
+func (cmd *detach) Run(ctx context.Context, f *flag.FlagSet) error {
+	id := f.Arg(0)
+	if id == "" {
+		return flag.ErrHelp
+	}
+
+	vm, err := cmd.VirtualMachine()
+	if err != nil {
+		return err
+	}
+	if vm == nil {
+		return flag.ErrHelp
+	}
+
+	return vm.DetachDisk(ctx, id)
+}
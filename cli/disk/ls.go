@@ -16,6 +16,8 @@ import (
 	"github.com/vmware/govmomi/cli"
 	"github.com/vmware/govmomi/cli/flags"
 	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/pbm"
+	pbmtypes "github.com/vmware/govmomi/pbm/types"
 	"github.com/vmware/govmomi/units"
 	"github.com/vmware/govmomi/vim25/types"
 )
@@ -29,6 +31,9 @@ type ls struct {
 	category string
 	tag      string
 	tags     bool
+	profile  string
+	profiles bool
+	snap     bool
 }
 
 func init() {
@@ -46,6 +51,9 @@ func (cmd *ls) Register(ctx context.Context, f *flag.FlagSet) {
 	f.StringVar(&cmd.category, "c", "", "Query tag category")
 	f.StringVar(&cmd.tag, "t", "", "Query tag name")
 	f.BoolVar(&cmd.tags, "T", false, "List attached tags")
+	f.StringVar(&cmd.profile, "profile", "", "Query storage profile name or ID")
+	f.BoolVar(&cmd.profiles, "P", false, "List attached storage profiles")
+	f.BoolVar(&cmd.snap, "S", false, "Include snapshot count")
 }
 
 func (cmd *ls) Usage() string {
@@ -59,12 +67,24 @@ Examples:
   govc disk.ls
   govc disk.ls -l -T
   govc disk.ls -l e9b06a8b-d047-4d3c-b15b-43ea9608b1a6
-  govc disk.ls -c k8s-region -t us-west-2`
+  govc disk.ls -c k8s-region -t us-west-2
+  govc disk.ls -profile my-policy
+  govc disk.ls -l -P
+  govc disk.ls -l -S`
 }
 
 type VStorageObject struct {
 	types.VStorageObject
-	Tags []types.VslmTagEntry `json:"tags"`
+	Tags      []types.VslmTagEntry `json:"tags"`
+	Profiles  []Profile            `json:"profiles,omitempty"`
+	Snapshots int                  `json:"snapshots,omitempty"`
+}
+
+// Profile is a storage profile associated with a VStorageObject, with the
+// profile name resolved for display/JSON consumers.
+type Profile struct {
+	pbmtypes.PbmProfileId
+	Name string `json:"name"`
 }
 
 func (o *VStorageObject) tags() string {
@@ -75,6 +95,14 @@ func (o *VStorageObject) tags() string {
 	return strings.Join(tags, ",")
 }
 
+func (o *VStorageObject) profilesString() string {
+	var names []string
+	for _, p := range o.Profiles {
+		names = append(names, p.Name)
+	}
+	return strings.Join(names, ",")
+}
+
 type lsResult struct {
 	cmd     *ls
 	Objects []VStorageObject `json:"objects"`
@@ -99,6 +127,12 @@ func (r *lsResult) Write(w io.Writer) error {
 		if r.cmd.tags {
 			_, _ = fmt.Fprintf(tw, "\t%s", o.tags())
 		}
+		if r.cmd.profiles {
+			_, _ = fmt.Fprintf(tw, "\t%s", o.profilesString())
+		}
+		if r.cmd.snap {
+			_, _ = fmt.Fprintf(tw, "\t%d", o.Snapshots)
+		}
 		_, _ = fmt.Fprintln(tw)
 	}
 
@@ -122,22 +156,50 @@ func (cmd *ls) Run(ctx context.Context, f *flag.FlagSet) error {
 	}
 	res := lsResult{cmd: cmd}
 
+	var pc *pbm.Client
+	if cmd.profile != "" || cmd.profiles {
+		pc, err = newProfileClient(ctx, cmd.DatastoreFlag.Client().Client)
+		if err != nil {
+			return err
+		}
+	}
+
 	filterNotFound := false
 	ids := f.Args()
 	if len(ids) == 0 {
 		filterNotFound = true
-		var oids []types.ID
-		if cmd.category == "" {
-			oids, err = m.List(ctx)
-		} else {
+		switch {
+		case cmd.profile != "":
+			ids, err = cmd.idsForProfile(ctx, pc)
+		case cmd.category != "":
+			var oids []types.ID
 			oids, err = m.ListAttachedObjects(ctx, cmd.category, cmd.tag)
+			for _, id := range oids {
+				ids = append(ids, id.Id)
+			}
+		default:
+			var oids []types.ID
+			oids, err = m.List(ctx)
+			for _, id := range oids {
+				ids = append(ids, id.Id)
+			}
 		}
 
 		if err != nil {
 			return err
 		}
-		for _, id := range oids {
-			ids = append(ids, id.Id)
+	} else if cmd.profile != "" {
+		ids, err = cmd.filterByProfile(ctx, pc, ids)
+		if err != nil {
+			return err
+		}
+	}
+
+	var profileNamesByID map[string]string
+	if cmd.profiles {
+		profileNamesByID, err = profileNames(ctx, pc)
+		if err != nil {
+			return err
 		}
 	}
 
@@ -169,8 +231,93 @@ func (cmd *ls) Run(ctx context.Context, f *flag.FlagSet) error {
 				return err
 			}
 		}
+		if cmd.profiles {
+			obj.Profiles, err = cmd.profilesFor(ctx, pc, id, profileNamesByID)
+			if err != nil {
+				return err
+			}
+		}
+		if cmd.snap {
+			info, err := m.RetrieveSnapshotInfo(ctx, types.ID{Id: id})
+			if err != nil {
+				return err
+			}
+			obj.Snapshots = len(info.Snapshots)
+		}
 		res.Objects = append(res.Objects, obj)
 	}
 
 	return cmd.WriteResult(&res)
 }
+
+// idsForProfile returns the FCD IDs associated with the -profile flag's
+// storage profile name or ID.
+func (cmd *ls) idsForProfile(ctx context.Context, pc *pbm.Client) ([]string, error) {
+	ids, err := entityIDsForProfileName(ctx, pc, cmd.profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// filterByProfile restricts ids to those associated with the -profile flag's
+// storage profile name or ID, so that e.g. "disk.ls -profile p id1 id2" only
+// lists the given IDs that are actually members of the profile.
+func (cmd *ls) filterByProfile(ctx context.Context, pc *pbm.Client, ids []string) ([]string, error) {
+	members, err := entityIDsForProfileName(ctx, pc, cmd.profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return filterIDs(ids, members), nil
+}
+
+// filterIDs returns the elements of ids that also appear in members,
+// preserving the order and backing array of ids.
+func filterIDs(ids, members []string) []string {
+	set := make(map[string]bool, len(members))
+	for _, id := range members {
+		set[id] = true
+	}
+
+	filtered := ids[:0]
+	for _, id := range ids {
+		if set[id] {
+			filtered = append(filtered, id)
+		}
+	}
+
+	return filtered
+}
+
+// entityIDsForProfileName resolves a storage profile name or ID to the FCD
+// IDs currently associated with it.
+func entityIDsForProfileName(ctx context.Context, pc *pbm.Client, profile string) ([]string, error) {
+	ids, err := resolveProfileIDs(ctx, pc, []string{profile})
+	if err != nil {
+		return nil, err
+	}
+
+	return entityIDsForProfile(ctx, pc, ids[0])
+}
+
+// profilesFor resolves the storage profiles currently associated with the
+// given FCD, with names filled in for display from the given name map, which
+// callers resolve once via profileNames and reuse across all FCDs.
+func (cmd *ls) profilesFor(ctx context.Context, pc *pbm.Client, id string, names map[string]string) ([]Profile, error) {
+	ids, err := profilesForEntity(ctx, pc, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	profiles := make([]Profile, 0, len(ids))
+	for _, pid := range ids {
+		profiles = append(profiles, Profile{PbmProfileId: pid, Name: names[pid.UniqueId]})
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,47 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterIDs(t *testing.T) {
+	tests := []struct {
+		name    string
+		ids     []string
+		members []string
+		want    []string
+	}{
+		{
+			name:    "explicit IDs restricted to profile members",
+			ids:     []string{"a", "b", "c"},
+			members: []string{"b", "c", "d"},
+			want:    []string{"b", "c"},
+		},
+		{
+			name:    "no overlap",
+			ids:     []string{"a", "b"},
+			members: []string{"c"},
+			want:    []string{},
+		},
+		{
+			name:    "all match",
+			ids:     []string{"a", "b"},
+			members: []string{"a", "b"},
+			want:    []string{"a", "b"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterIDs(tt.ids, tt.members)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterIDs(%v, %v) = %v, want %v", tt.ids, tt.members, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package disk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/govmomi/pbm"
+	pbmtypes "github.com/vmware/govmomi/pbm/types"
+	"github.com/vmware/govmomi/vim25/soap"
+)
+
+// newProfileClient creates a pbm.Client for resolving and querying SPBM
+// storage profiles against the given vCenter SOAP client.
+func newProfileClient(ctx context.Context, sc *soap.Client) (*pbm.Client, error) {
+	c, err := pbm.NewClient(ctx, sc)
+	if err != nil {
+		return nil, fmt.Errorf("pbm client: %s", err)
+	}
+	return c, nil
+}
+
+// allProfiles returns every SPBM storage profile of the REQUIREMENT category
+// (VM storage policies) known to c; profiles of other categories are not
+// returned.
+func allProfiles(ctx context.Context, c *pbm.Client) ([]pbmtypes.BasePbmProfile, error) {
+	rtype := pbmtypes.PbmProfileResourceType{
+		ResourceType: string(pbmtypes.PbmProfileResourceTypeEnumSTORAGE),
+	}
+	cat := string(pbmtypes.PbmProfileCategoryEnumREQUIREMENT)
+
+	ids, err := c.QueryProfile(ctx, rtype, cat)
+	if err != nil {
+		return nil, fmt.Errorf("query profiles: %s", err)
+	}
+
+	profiles, err := c.RetrieveContent(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("retrieve profile content: %s", err)
+	}
+
+	return profiles, nil
+}
+
+// resolveProfileIDs resolves one or more SPBM storage profile names or IDs to
+// pbmtypes.PbmProfileId values.
+func resolveProfileIDs(ctx context.Context, c *pbm.Client, names []string) ([]pbmtypes.PbmProfileId, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	profiles, err := allProfiles(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []pbmtypes.PbmProfileId
+	for _, name := range names {
+		id, err := profileIDByName(profiles, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, id)
+	}
+
+	return result, nil
+}
+
+func profileIDByName(profiles []pbmtypes.BasePbmProfile, name string) (pbmtypes.PbmProfileId, error) {
+	for _, p := range profiles {
+		profile, ok := p.(*pbmtypes.PbmCapabilityProfile)
+		if !ok {
+			continue
+		}
+		if profile.ProfileId.UniqueId == name || profile.Name == name {
+			return profile.ProfileId, nil
+		}
+	}
+
+	return pbmtypes.PbmProfileId{}, fmt.Errorf("storage profile %q not found", name)
+}
+
+// profileNames resolves the storage profiles returned by allProfiles into a
+// map of profile ID to display name, used to annotate FCDs with human
+// readable profile names.
+func profileNames(ctx context.Context, c *pbm.Client) (map[string]string, error) {
+	profiles, err := allProfiles(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]string, len(profiles))
+	for _, p := range profiles {
+		profile, ok := p.(*pbmtypes.PbmCapabilityProfile)
+		if !ok {
+			continue
+		}
+		names[profile.ProfileId.UniqueId] = profile.Name
+	}
+
+	return names, nil
+}
+
+// entityIDsForProfile returns the FCD IDs associated with the given storage
+// profile.
+func entityIDsForProfile(ctx context.Context, c *pbm.Client, id pbmtypes.PbmProfileId) ([]string, error) {
+	entities, err := c.QueryAssociatedEntity(ctx, id, string(pbmtypes.PbmObjectTypeVirtualDiskId))
+	if err != nil {
+		return nil, fmt.Errorf("query associated entities: %s", err)
+	}
+
+	ids := make([]string, 0, len(entities))
+	for _, e := range entities {
+		ids = append(ids, e.Key)
+	}
+
+	return ids, nil
+}
+
+// profilesForEntity returns the storage profiles currently associated with
+// the given FCD.
+func profilesForEntity(ctx context.Context, c *pbm.Client, id string) ([]pbmtypes.PbmProfileId, error) {
+	entity := pbmtypes.PbmServerObjectRef{
+		ObjectType: string(pbmtypes.PbmObjectTypeVirtualDiskId),
+		Key:        id,
+	}
+
+	res, err := c.QueryAssociatedProfiles(ctx, []pbmtypes.PbmServerObjectRef{entity})
+	if err != nil {
+		return nil, fmt.Errorf("query associated profiles: %s", err)
+	}
+
+	var ids []pbmtypes.PbmProfileId
+	for _, r := range res {
+		ids = append(ids, r.ProfileId...)
+	}
+
+	return ids, nil
+}
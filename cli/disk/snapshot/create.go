@@ -0,0 +1,72 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/disk"
+	"github.com/vmware/govmomi/cli/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type create struct {
+	*flags.DatastoreFlag
+
+	description string
+}
+
+func init() {
+	cli.Register("disk.snapshot.create", &create{})
+}
+
+func (cmd *create) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.description, "d", "", "Snapshot description")
+}
+
+func (cmd *create) Usage() string {
+	return "ID"
+}
+
+func (cmd *create) Description() string {
+	return `Create a snapshot of an FCD.
+
+Examples:
+  govc disk.snapshot.create -d "before upgrade" e9b06a8b-d047-4d3c-b15b-43ea9608b1a6`
+}
+
+func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
+	id := f.Arg(0)
+	if id == "" {
+		return flag.ErrHelp
+	}
+
+	m, err := disk.NewManagerFromFlag(ctx, cmd.DatastoreFlag)
+	if err != nil {
+		return err
+	}
+
+	task, err := m.CreateSnapshot(ctx, types.ID{Id: id}, cmd.description)
+	if err != nil {
+		return err
+	}
+
+	res, err := task.WaitForResult(ctx)
+	if err != nil {
+		return err
+	}
+
+	if sid, ok := res.Result.(types.ID); ok {
+		fmt.Fprintln(cmd.Out, sid.Id)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/disk"
+	"github.com/vmware/govmomi/cli/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type ls struct {
+	*flags.DatastoreFlag
+}
+
+func init() {
+	cli.Register("disk.snapshot.ls", &ls{})
+}
+
+func (cmd *ls) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+}
+
+func (cmd *ls) Usage() string {
+	return "ID"
+}
+
+func (cmd *ls) Description() string {
+	return `List snapshots of an FCD.
+
+Examples:
+  govc disk.snapshot.ls e9b06a8b-d047-4d3c-b15b-43ea9608b1a6`
+}
+
+type lsResult struct {
+	Snapshots []types.VStorageObjectSnapshotInfoVStorageObjectSnapshot `json:"snapshots"`
+}
+
+func (r *lsResult) Write(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 2, 0, 2, ' ', 0)
+
+	for _, s := range r.Snapshots {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\n", s.Id.Id, s.Description, s.CreateTime.Format(time.Stamp))
+	}
+
+	return tw.Flush()
+}
+
+func (r *lsResult) Dump() interface{} {
+	return r.Snapshots
+}
+
+func (cmd *ls) Run(ctx context.Context, f *flag.FlagSet) error {
+	id := f.Arg(0)
+	if id == "" {
+		return flag.ErrHelp
+	}
+
+	m, err := disk.NewManagerFromFlag(ctx, cmd.DatastoreFlag)
+	if err != nil {
+		return err
+	}
+
+	info, err := m.RetrieveSnapshotInfo(ctx, types.ID{Id: id})
+	if err != nil {
+		return err
+	}
+
+	return cmd.WriteResult(&lsResult{Snapshots: info.Snapshots})
+}
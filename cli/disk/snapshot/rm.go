@@ -0,0 +1,58 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/disk"
+	"github.com/vmware/govmomi/cli/flags"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+type rm struct {
+	*flags.DatastoreFlag
+}
+
+func init() {
+	cli.Register("disk.snapshot.rm", &rm{})
+}
+
+func (cmd *rm) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.DatastoreFlag, ctx = flags.NewDatastoreFlag(ctx)
+	cmd.DatastoreFlag.Register(ctx, f)
+}
+
+func (cmd *rm) Usage() string {
+	return "ID SNAPSHOT-ID"
+}
+
+func (cmd *rm) Description() string {
+	return `Delete a snapshot of an FCD.
+
+Examples:
+  govc disk.snapshot.rm e9b06a8b-d047-4d3c-b15b-43ea9608b1a6 de0f5d9e-6e5b-4f5f-9c1a-df3a9e7f1234`
+}
+
+func (cmd *rm) Run(ctx context.Context, f *flag.FlagSet) error {
+	id, sid := f.Arg(0), f.Arg(1)
+	if id == "" || sid == "" {
+		return flag.ErrHelp
+	}
+
+	m, err := disk.NewManagerFromFlag(ctx, cmd.DatastoreFlag)
+	if err != nil {
+		return err
+	}
+
+	task, err := m.DeleteSnapshot(ctx, types.ID{Id: id}, types.ID{Id: sid})
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
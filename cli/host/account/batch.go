@@ -0,0 +1,129 @@
+/*
+Copyright (c) 2016 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// accountResult is the outcome of a single account operation (create,
+// update, or remove) within a batch, used to reconcile successes and
+// failures when many accounts are processed in one command invocation.
+type accountResult struct {
+	ID      string `json:"id"`
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+type accountResults []accountResult
+
+func (r accountResults) Write(w io.Writer) error {
+	for _, res := range r {
+		if res.Success {
+			_, _ = fmt.Fprintf(w, "%s: ok\n", res.ID)
+		} else {
+			_, _ = fmt.Fprintf(w, "%s: %s\n", res.ID, res.Error)
+		}
+	}
+	return nil
+}
+
+func (r accountResults) Dump() interface{} {
+	return r
+}
+
+// openBatchFile opens name for batch reading, treating "-" as stdin.
+func openBatchFile(name string) (io.ReadCloser, error) {
+	if name == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	return os.Open(name)
+}
+
+// readIDs reads a newline- or CSV-delimited list of account IDs from name
+// (or stdin if name is "-"), for use by host.account.remove's -f flag.
+func readIDs(name string) ([]string, error) {
+	f, err := openBatchFile(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var ids []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		for _, id := range strings.Split(line, ",") {
+			id = strings.TrimSpace(id)
+			if id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids, s.Err()
+}
+
+// readAccountRows reads id,password,description rows from a CSV file (or
+// stdin if name is "-"), for use by host.account.create and
+// host.account.update's -f flag. Password and description are optional.
+func readAccountRows(name string) ([]types.HostAccountSpec, error) {
+	f, err := openBatchFile(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	cr := csv.NewReader(f)
+	cr.FieldsPerRecord = -1
+
+	var specs []types.HostAccountSpec
+	for {
+		rec, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rec) == 0 || strings.TrimSpace(rec[0]) == "" {
+			continue
+		}
+
+		spec := types.HostAccountSpec{Id: strings.TrimSpace(rec[0])}
+		if len(rec) > 1 {
+			spec.Password = strings.TrimSpace(rec[1])
+		}
+		if len(rec) > 2 {
+			spec.Description = strings.TrimSpace(rec[2])
+		}
+
+		specs = append(specs, spec)
+	}
+
+	return specs, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright (c) 2016 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, contents string) string {
+	t.Helper()
+
+	name := filepath.Join(t.TempDir(), "batch")
+	if err := os.WriteFile(name, []byte(contents), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	return name
+}
+
+func TestReadIDs(t *testing.T) {
+	name := writeTempFile(t, "user1\nuser2, user3\n\nuser4\n")
+
+	ids, err := readIDs(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"user1", "user2", "user3", "user4"}
+	if !reflect.DeepEqual(ids, want) {
+		t.Errorf("readIDs() = %v, want %v", ids, want)
+	}
+}
+
+func TestReadAccountRows(t *testing.T) {
+	name := writeTempFile(t, "user1,pass1,desc one\nuser2,pass2\nuser3\n")
+
+	specs, err := readAccountRows(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(specs) != 3 {
+		t.Fatalf("got %d specs, want 3", len(specs))
+	}
+
+	if specs[0].Id != "user1" || specs[0].Password != "pass1" || specs[0].Description != "desc one" {
+		t.Errorf("specs[0] = %+v", specs[0])
+	}
+	if specs[1].Id != "user2" || specs[1].Password != "pass2" || specs[1].Description != "" {
+		t.Errorf("specs[1] = %+v", specs[1])
+	}
+	if specs[2].Id != "user3" || specs[2].Password != "" || specs[2].Description != "" {
+		t.Errorf("specs[2] = %+v", specs[2])
+	}
+}
+
+func TestAccountResultsReconciliation(t *testing.T) {
+	// Mirrors the -continue bookkeeping in remove/create/update's Run: every
+	// ID is attempted and its outcome recorded, rather than stopping at the
+	// first failure.
+	ids := []string{"ok1", "bad1", "ok2", "bad2"}
+	fails := map[string]bool{"bad1": true, "bad2": true}
+
+	var results accountResults
+	for _, id := range ids {
+		res := accountResult{ID: id, Success: !fails[id]}
+		if fails[id] {
+			res.Error = "simulated failure"
+		}
+		results = append(results, res)
+	}
+
+	if len(results) != len(ids) {
+		t.Fatalf("got %d results, want %d", len(results), len(ids))
+	}
+
+	for _, res := range results {
+		if res.Success == fails[res.ID] {
+			t.Errorf("result for %s: Success=%v, want %v", res.ID, res.Success, !fails[res.ID])
+		}
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) 2016 VMware, Inc. All Rights Reserved.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package account
+
+import (
+	"context"
+	"flag"
+
+	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
+)
+
+type create struct {
+	*AccountFlag
+	*flags.OutputFlag
+
+	file string
+	cont bool
+}
+
+func init() {
+	cli.Register("host.account.create", &create{})
+}
+
+func (cmd *create) Register(ctx context.Context, f *flag.FlagSet) {
+	cmd.AccountFlag, ctx = newAccountFlag(ctx)
+	cmd.AccountFlag.Register(ctx, f)
+
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
+
+	f.StringVar(&cmd.file, "f", "", "Create accounts from a CSV file of id,password,description rows, '-' for stdin")
+	f.BoolVar(&cmd.cont, "continue", false, "Continue creating remaining accounts if one fails")
+}
+
+func (cmd *create) Process(ctx context.Context) error {
+	if err := cmd.AccountFlag.Process(ctx); err != nil {
+		return err
+	}
+	return cmd.OutputFlag.Process(ctx)
+}
+
+func (cmd *create) Description() string {
+	return `Create local account on HOST.
+
+With -f, accounts can be provisioned in bulk from a CSV file of
+id,password,description rows (password and description are optional) --
+useful for standing up break-glass accounts across many hosts via a
+script loop.
+
+Examples:
+  govc host.account.create -id $USER -password $PASS
+  govc host.account.create -f accounts.csv`
+}
+
+func (cmd *create) Run(ctx context.Context, f *flag.FlagSet) error {
+	m, err := cmd.AccountFlag.HostAccountManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	if cmd.file == "" {
+		return m.Create(ctx, &cmd.HostAccountSpec)
+	}
+
+	specs, err := readAccountRows(cmd.file)
+	if err != nil {
+		return err
+	}
+
+	var results accountResults
+	for _, spec := range specs {
+		spec := spec
+		rerr := m.Create(ctx, &spec)
+		res := accountResult{ID: spec.Id, Success: rerr == nil}
+		if rerr != nil {
+			res.Error = rerr.Error()
+			if !cmd.cont {
+				return rerr
+			}
+		}
+		results = append(results, res)
+	}
+
+	return cmd.WriteResult(results)
+}
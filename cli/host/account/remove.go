@@ -21,10 +21,15 @@ import (
 	"flag"
 
 	"github.com/vmware/govmomi/cli"
+	"github.com/vmware/govmomi/cli/flags"
 )
 
 type remove struct {
 	*AccountFlag
+	*flags.OutputFlag
+
+	file string
+	cont bool
 }
 
 func init() {
@@ -34,26 +39,91 @@ func init() {
 func (cmd *remove) Register(ctx context.Context, f *flag.FlagSet) {
 	cmd.AccountFlag, ctx = newAccountFlag(ctx)
 	cmd.AccountFlag.Register(ctx, f)
-}
 
-func (cmd *remove) Description() string {
-	return `Remove local account on HOST.
+	cmd.OutputFlag, ctx = flags.NewOutputFlag(ctx)
+	cmd.OutputFlag.Register(ctx, f)
 
-Examples:
-  govc host.account.remove -id $USER`
+	f.StringVar(&cmd.file, "f", "", "Remove accounts with IDs read from file, one or more per line or CSV, '-' for stdin")
+	f.BoolVar(&cmd.cont, "continue", false, "Continue removing remaining accounts if one fails")
 }
 
 func (cmd *remove) Process(ctx context.Context) error {
 	if err := cmd.AccountFlag.Process(ctx); err != nil {
 		return err
 	}
-	return nil
+	return cmd.OutputFlag.Process(ctx)
+}
+
+func (cmd *remove) Usage() string {
+	return "[ID]..."
+}
+
+func (cmd *remove) Description() string {
+	return `Remove local account(s) on HOST.
+
+IDs may be given as positional arguments, via -id, or via -f to read a
+newline- or CSV-delimited list of IDs from a file ('-' for stdin). Without
+-continue, the command stops at the first account that fails to remove;
+with -continue every ID is attempted and successes/failures are reported
+in the result.
+
+Examples:
+  govc host.account.remove -id $USER
+  govc host.account.remove user1 user2 user3
+  govc host.account.remove -continue -f ids.txt`
+}
+
+func (cmd *remove) ids(f *flag.FlagSet) ([]string, error) {
+	var ids []string
+
+	ids = append(ids, f.Args()...)
+
+	if cmd.HostAccountSpec.Id != "" {
+		ids = append(ids, cmd.HostAccountSpec.Id)
+	}
+
+	if cmd.file != "" {
+		fids, err := readIDs(cmd.file)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, fids...)
+	}
+
+	if len(ids) == 0 {
+		return nil, flag.ErrHelp
+	}
+
+	return ids, nil
 }
 
 func (cmd *remove) Run(ctx context.Context, f *flag.FlagSet) error {
+	ids, err := cmd.ids(f)
+	if err != nil {
+		return err
+	}
+
 	m, err := cmd.AccountFlag.HostAccountManager(ctx)
 	if err != nil {
 		return err
 	}
-	return m.Remove(ctx, cmd.HostAccountSpec.Id)
+
+	if len(ids) == 1 && cmd.file == "" && !cmd.cont {
+		return m.Remove(ctx, ids[0])
+	}
+
+	var results accountResults
+	for _, id := range ids {
+		rerr := m.Remove(ctx, id)
+		res := accountResult{ID: id, Success: rerr == nil}
+		if rerr != nil {
+			res.Error = rerr.Error()
+			if !cmd.cont {
+				return rerr
+			}
+		}
+		results = append(results, res)
+	}
+
+	return cmd.WriteResult(results)
 }
@@ -0,0 +1,181 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package object
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/vmware/govmomi/fault"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// DatastoreFileManager provides a uniform way to delete files on a
+// Datastore, regardless of whether they are plain files, vmdk-backed virtual
+// disks, or (on a vsanDatastore) DOM objects with no corresponding file path.
+type DatastoreFileManager struct {
+	Datastore *Datastore
+
+	force  bool
+	isVSAN bool
+
+	fm  *FileManager
+	vdm *VirtualDiskManager
+}
+
+// NewDatastoreFileManager creates a DatastoreFileManager for ds. force, when
+// set, causes Delete to ignore a "file does not exist" fault rather than
+// return it. isVSAN tells the manager whether ds is a vsanDatastore, so that
+// Delete knows to look for vSAN DOM objects orphaned by the delete; callers
+// resolve this once (e.g. from Datastore summary) rather than have every
+// Delete call re-fetch it.
+func NewDatastoreFileManager(ds *Datastore, force, isVSAN bool) *DatastoreFileManager {
+	c := ds.Client()
+
+	return &DatastoreFileManager{
+		Datastore: ds,
+		force:     force,
+		isVSAN:    isVSAN,
+		fm:        NewFileManager(c),
+		vdm:       NewVirtualDiskManager(c),
+	}
+}
+
+// Delete removes the file or directory at name on the Datastore, inferring
+// whether it is a vmdk-backed virtual disk from its ".vmdk" suffix. Use
+// DeleteAs to override that detection.
+func (m *DatastoreFileManager) Delete(ctx context.Context, name string) error {
+	return m.DeleteAs(ctx, name, strings.HasSuffix(name, ".vmdk"))
+}
+
+// DeleteAs removes the file or directory at name on the Datastore. asDisk
+// forces removal via VirtualDiskManager, so that all of a vmdk's extents are
+// cleaned up, regardless of name's suffix; otherwise the file is removed via
+// FileManager. On a vsanDatastore, any vSAN DOM objects left orphaned by the
+// delete are cleaned up as well, since they are not file-backed and are
+// otherwise invisible to FileManager.
+func (m *DatastoreFileManager) DeleteAs(ctx context.Context, name string, asDisk bool) error {
+	dc, err := m.Datastore.Datacenter()
+	if err != nil {
+		return err
+	}
+
+	dsPath := m.Datastore.Path(name)
+
+	var task *Task
+	if asDisk {
+		task, err = m.vdm.DeleteVirtualDisk(ctx, dsPath, dc)
+	} else {
+		task, err = m.fm.DeleteDatastoreFile(ctx, dsPath, dc)
+	}
+	if err != nil {
+		return m.ignoreNotFound(err)
+	}
+
+	if err = task.Wait(ctx); err != nil {
+		return m.ignoreNotFound(err)
+	}
+
+	return m.cleanupVsanObjects(ctx, name)
+}
+
+// ignoreNotFound returns nil in place of a "file does not exist" fault when
+// the manager was constructed with force set.
+func (m *DatastoreFileManager) ignoreNotFound(err error) error {
+	if m.force && fault.Is(err, &types.FileNotFound{}) {
+		return nil
+	}
+
+	return err
+}
+
+// cleanupVsanObjects removes the vSAN DOM objects backing name, if the
+// Datastore is a vsanDatastore. Errors resolving vSAN state are ignored: this
+// is a best-effort cleanup on top of a delete that has already succeeded.
+func (m *DatastoreFileManager) cleanupVsanObjects(ctx context.Context, name string) error {
+	if !m.isVSAN {
+		return nil
+	}
+
+	hosts, err := m.Datastore.AttachedHosts(ctx)
+	if err != nil || len(hosts) == 0 {
+		return nil
+	}
+
+	vsan, err := hosts[0].ConfigManager().VsanInternalSystem(ctx)
+	if err != nil {
+		return nil
+	}
+
+	uuids, err := vsan.QueryVsanObjectUuidsByFilter(ctx, nil, 0)
+	if err != nil {
+		return nil
+	}
+
+	attrs, err := vsan.GetVsanObjExtAttrs(ctx, uuids)
+	if err != nil {
+		return nil
+	}
+
+	nsID := vsanNamespaceID(name, attrs)
+	if nsID == "" {
+		return nil
+	}
+
+	orphaned := []string{nsID}
+	for id, a := range attrs {
+		if id != nsID && a.Owner == nsID {
+			orphaned = append(orphaned, id)
+		}
+	}
+
+	_, err = vsan.DeleteVsanObjects(ctx, orphaned, false)
+	return err
+}
+
+// vsanNamespaceID resolves the vSAN namespace object UUID backing a deleted
+// datastore path, given the ext attrs of every vSAN DOM object known to the
+// host. A loose FCD's directory is itself named after its object UUID, so
+// that case is detected directly; a VM's home directory is instead named
+// after the VM (e.g. "my-vm"), so it's matched against the namespace object
+// whose "User friendly name" attr equals the directory name. Returns "" if
+// neither matches.
+func vsanNamespaceID(p string, attrs map[string]VsanObjExtAttrs) string {
+	name := path.Base(strings.TrimSuffix(p, "/"))
+	if isUUID(name) {
+		return name
+	}
+
+	for id, a := range attrs {
+		if a.ObjectType == "namespace" && a.Name == name {
+			return id
+		}
+	}
+
+	return ""
+}
+
+// isUUID reports whether s has the canonical 8-4-4-4-12 hex UUID form.
+func isUUID(s string) bool {
+	if len(s) != 36 {
+		return false
+	}
+
+	for i, c := range s {
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if !((c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,114 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package object
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/vmware/govmomi/property"
+	"github.com/vmware/govmomi/vim25"
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// HostVsanInternalSystem wraps the HostVsanInternalSystem managed object,
+// which provides low level access to vSAN DOM objects for diagnostics and
+// cleanup purposes.
+type HostVsanInternalSystem struct {
+	Common
+}
+
+// NewHostVsanInternalSystem creates a HostVsanInternalSystem wrapper for ref.
+func NewHostVsanInternalSystem(c *vim25.Client, ref types.ManagedObjectReference) *HostVsanInternalSystem {
+	return &HostVsanInternalSystem{
+		Common: NewCommon(c, ref),
+	}
+}
+
+// VsanObjExtAttrs is the subset of a vSAN DOM object's extended attributes
+// that govc surfaces, decoded from the JSON returned by GetVsanObjExtAttrs.
+type VsanObjExtAttrs struct {
+	ObjectClass string `json:"Object class,omitempty"`
+	ObjectType  string `json:"Object type,omitempty"`
+	Owner       string `json:"Object owner,omitempty"`
+	Name        string `json:"User friendly name,omitempty"`
+}
+
+// QueryVsanObjectUuidsByFilter returns the UUIDs of vSAN DOM objects known to
+// the host, optionally filtered to uuids and capped at limit results (0 for
+// no limit).
+func (s HostVsanInternalSystem) QueryVsanObjectUuidsByFilter(ctx context.Context, uuids []string, limit int32) ([]string, error) {
+	req := types.QueryVsanObjectUuidsByFilter{
+		This:  s.Reference(),
+		Uuids: uuids,
+		Limit: limit,
+	}
+
+	res, err := methods.QueryVsanObjectUuidsByFilter(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// GetVsanObjExtAttrs returns the extended attributes of the given vSAN DOM
+// object UUIDs, keyed by UUID.
+func (s HostVsanInternalSystem) GetVsanObjExtAttrs(ctx context.Context, uuids []string) (map[string]VsanObjExtAttrs, error) {
+	req := types.GetVsanObjExtAttrs{
+		This:  s.Reference(),
+		Uuids: uuids,
+	}
+
+	res, err := methods.GetVsanObjExtAttrs(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := make(map[string]VsanObjExtAttrs)
+	if err := json.Unmarshal([]byte(res.Returnval), &attrs); err != nil {
+		return nil, fmt.Errorf("decode vsan object ext attrs: %s", err)
+	}
+
+	return attrs, nil
+}
+
+// DeleteVsanObjects deletes the given vSAN DOM object UUIDs. When force is
+// true, objects are deleted even if they are still referenced, such as when
+// the referencing VM has already been destroyed out of band.
+func (s HostVsanInternalSystem) DeleteVsanObjects(ctx context.Context, uuids []string, force bool) ([]types.HostVsanInternalSystemDeleteVsanObjectsResult, error) {
+	req := types.DeleteVsanObjects{
+		This:  s.Reference(),
+		Uuids: uuids,
+		Force: &force,
+	}
+
+	res, err := methods.DeleteVsanObjects(ctx, s.Client(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Returnval, nil
+}
+
+// VsanInternalSystem returns the HostVsanInternalSystem for this host's
+// ConfigManager.
+func (m HostConfigManager) VsanInternalSystem(ctx context.Context) (*HostVsanInternalSystem, error) {
+	var h mo.HostSystem
+
+	err := property.DefaultCollector(m.Client()).RetrieveOne(ctx, m.Reference(), []string{"configManager.vsanInternalSystem"}, &h)
+	if err != nil {
+		return nil, err
+	}
+
+	if h.ConfigManager.VsanInternalSystem == nil {
+		return nil, fmt.Errorf("vsanInternalSystem not supported by %s", m.Reference())
+	}
+
+	return NewHostVsanInternalSystem(m.Client(), *h.ConfigManager.VsanInternalSystem), nil
+}
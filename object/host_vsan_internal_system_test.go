@@ -0,0 +1,54 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package object
+
+import "testing"
+
+func TestIsUUID(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"e9b06a8b-d047-4d3c-b15b-43ea9608b1a6", true},
+		{"E9B06A8B-D047-4D3C-B15B-43EA9608B1A6", true},
+		{"my-vm", false},
+		{"", false},
+		{"e9b06a8b-d047-4d3c-b15b-43ea9608b1a", false},  // too short
+		{"e9b06a8bxd047-4d3c-b15b-43ea9608b1a6", false}, // bad separator
+		{"zzb06a8b-d047-4d3c-b15b-43ea9608b1a6", false}, // non-hex
+	}
+
+	for _, tt := range tests {
+		if got := isUUID(tt.s); got != tt.want {
+			t.Errorf("isUUID(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestVsanNamespaceID(t *testing.T) {
+	attrs := map[string]VsanObjExtAttrs{
+		"e9b06a8b-d047-4d3c-b15b-43ea9608b1a6": {ObjectType: "namespace", Name: "my-vm"},
+		"de0f5d9e-6e5b-4f5f-9c1a-df3a9e7f1234": {ObjectType: "vmnamespace", Owner: "e9b06a8b-d047-4d3c-b15b-43ea9608b1a6"},
+	}
+
+	tests := []struct {
+		name string
+		p    string
+		want string
+	}{
+		{"loose FCD directory named by its own UUID", "e9b06a8b-d047-4d3c-b15b-43ea9608b1a6", "e9b06a8b-d047-4d3c-b15b-43ea9608b1a6"},
+		{"VM directory matched by friendly name", "my-vm", "e9b06a8b-d047-4d3c-b15b-43ea9608b1a6"},
+		{"trailing slash is ignored", "my-vm/", "e9b06a8b-d047-4d3c-b15b-43ea9608b1a6"},
+		{"unrelated name has no match", "some-other-vm", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := vsanNamespaceID(tt.p, attrs); got != tt.want {
+				t.Errorf("vsanNamespaceID(%q) = %q, want %q", tt.p, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// © Broadcom. All Rights Reserved.
+// The term “Broadcom” refers to Broadcom Inc. and/or its subsidiaries.
+// SPDX-License-Identifier: Apache-2.0
+
+package object
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/vmware/govmomi/vim25/methods"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+// AttachDisk attaches an existing First Class Disk (FCD) identified by id to
+// the VirtualMachine. unitNumber is a pointer so that callers can omit it and
+// let vCenter pick the next available unit number on the controller.
+// Storage profiles, if any, are assigned to the resulting virtual disk device
+// via a follow-up reconfigure.
+func (v VirtualMachine) AttachDisk(ctx context.Context, id string, ds types.ManagedObjectReference, controllerKey int32, unitNumber *int32, profile []types.BaseVirtualMachineProfileSpec) error {
+	c := v.Client()
+
+	req := types.AttachDisk_Task{
+		This:          v.Reference(),
+		DiskId:        types.ID{Id: id},
+		Datastore:     ds,
+		ControllerKey: controllerKey,
+		UnitNumber:    unitNumber,
+	}
+
+	res, err := methods.AttachDisk_Task(ctx, c, &req)
+	if err != nil {
+		return err
+	}
+
+	task := NewTask(c, res.Returnval)
+	if err = task.Wait(ctx); err != nil {
+		return err
+	}
+
+	if len(profile) == 0 {
+		return nil
+	}
+
+	return v.assignDiskProfile(ctx, id, profile)
+}
+
+// assignDiskProfile locates the virtual disk device backed by id and applies
+// the given storage profiles to it via VirtualMachine.Reconfigure.
+func (v VirtualMachine) assignDiskProfile(ctx context.Context, id string, profile []types.BaseVirtualMachineProfileSpec) error {
+	devices, err := v.Device(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		disk, ok := d.(*types.VirtualDisk)
+		if !ok {
+			continue
+		}
+
+		if disk.VDiskId != nil && disk.VDiskId.Id == id {
+			spec := types.VirtualDeviceConfigSpec{
+				Operation: types.VirtualDeviceConfigSpecOperationEdit,
+				Device:    disk,
+				Profile:   profile,
+			}
+
+			return v.configureDevice(ctx, spec)
+		}
+	}
+
+	return fmt.Errorf("disk %q not found on %s after attach; storage profile not applied", id, v.Reference())
+}
+
+func (v VirtualMachine) configureDevice(ctx context.Context, spec types.VirtualDeviceConfigSpec) error {
+	task, err := v.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+		DeviceChange: []types.BaseVirtualDeviceConfigSpec{&spec},
+	})
+	if err != nil {
+		return err
+	}
+
+	return task.Wait(ctx)
+}
+
+// DetachDisk detaches the FCD identified by id from the VirtualMachine.
+func (v VirtualMachine) DetachDisk(ctx context.Context, id string) error {
+	c := v.Client()
+
+	req := types.DetachDisk_Task{
+		This:   v.Reference(),
+		DiskId: types.ID{Id: id},
+	}
+
+	res, err := methods.DetachDisk_Task(ctx, c, &req)
+	if err != nil {
+		return err
+	}
+
+	task := NewTask(c, res.Returnval)
+	return task.Wait(ctx)
+}